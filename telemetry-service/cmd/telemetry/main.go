@@ -1,17 +1,45 @@
 package main
 import (
+	"context"
 	"log"
-	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"telemetry-service/internal/config"
+	"telemetry-service/internal/httpserver"
 	"telemetry-service/internal/k8s"
+	"telemetry-service/internal/scheduler"
 )
 func main() {
-	fmt.Println("Main Function")
+	log.Println("Starting telemetry-service")
 	cfg := config.Load()
-	_ = cfg 
+
 	client,err := k8s.NewClient()
 	if err != nil {
 		log.Fatal("Failed to create k8s client", err)
 	}
 	log.Println("Successfully created k8s client",client)
-}
\ No newline at end of file
+
+	sched := scheduler.New(cfg, client)
+	sched.Start()
+
+	admin := httpserver.New(cfg, sched)
+	admin.Start()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
+	<-sigCh
+
+	log.Println("Shutdown signal received, draining in-flight work....!")
+	sched.Stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := admin.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Admin HTTP server shutdown error: %v", err)
+	}
+
+	log.Println("Shutdown complete")
+}
@@ -17,8 +17,37 @@ type Config struct {
 	//Events / AI Service
 	EventSinkURL string
 	EventTimeout time.Duration
+	EventMaxRetries int
+	EventRetryBackoffBase time.Duration
+	EventRetryBackoffMax time.Duration
+	//Dead-letter queue
+	DLQDir string
+	DLQMaxEntries int
+	DLQDrainInterval time.Duration
 	//HTTP Server
 	HTTPPort string
+	//Detectors
+	Detectors []DetectorConfig
+	//Correlator
+	CorrelatorStoreDir       string
+	CorrelatorCoalesceWindow time.Duration
+	CorrelatorCooldown       time.Duration
+}
+
+// DetectorConfig describes one entry in the detector registry: which
+// implementation to build (Type), whether it's active, and the
+// thresholds/windows it should be constructed with. Fields that don't
+// apply to a given detector type are left zero.
+type DetectorConfig struct {
+	Type            string
+	Enabled         bool
+	MetricType      string
+	Threshold       float64
+	WindowSize      int
+	WarmupSamples   int
+	HysteresisCount int
+	ZScoreThreshold float64
+	EWMAAlpha       float64
 }
 
 func Load() *Config {
@@ -29,14 +58,53 @@ func Load() *Config {
 		PollInterval: getDurationEnv("POLL_INTERVAL", 30*time.Second),
 		EventSinkURL: getenv("EVENT_SINK_URL", "http://localhost:8080/events"),
 		EventTimeout: getDurationEnv("EVENT_TIMEOUT", 10*time.Second),
+		EventMaxRetries: getIntEnv("EVENT_MAX_RETRIES", 5),
+		EventRetryBackoffBase: getDurationEnv("EVENT_RETRY_BACKOFF_BASE", 1*time.Second),
+		EventRetryBackoffMax: getDurationEnv("EVENT_RETRY_BACKOFF_MAX", 30*time.Second),
+		DLQDir: getenv("DLQ_DIR", "/var/lib/telemetry-service/dlq"),
+		DLQMaxEntries: getIntEnv("DLQ_MAX_ENTRIES", 1000),
+		DLQDrainInterval: getDurationEnv("DLQ_DRAIN_INTERVAL", 60*time.Second),
 		HTTPPort: getenv("HTTP_PORT", "8080"),
+		CorrelatorStoreDir: getenv("CORRELATOR_STORE_DIR", "/var/lib/telemetry-service/incidents.db"),
+		CorrelatorCoalesceWindow: getDurationEnv("CORRELATOR_COALESCE_WINDOW", 5*time.Minute),
+		CorrelatorCooldown: getDurationEnv("CORRELATOR_COOLDOWN", 10*time.Minute),
 
 		}
+		cfg.Detectors = loadDetectorConfigs()
 		validate(cfg)
 		logConfig(cfg)
 		return cfg
 }
 
+func loadDetectorConfigs() []DetectorConfig {
+	return []DetectorConfig{
+		{
+			Type:      "cpu_spike",
+			Enabled:   getBoolEnv("CPU_SPIKE_ENABLED", true),
+			Threshold: getFloatEnv("CPU_SPIKE_THRESHOLD", 600),
+		},
+		{
+			Type:            "anomaly",
+			Enabled:         getBoolEnv("ANOMALY_DETECTOR_ENABLED", true),
+			WindowSize:      getIntEnv("ANOMALY_WINDOW_SIZE", 20),
+			WarmupSamples:   getIntEnv("ANOMALY_WARMUP_SAMPLES", 20),
+			HysteresisCount: getIntEnv("ANOMALY_HYSTERESIS_SAMPLES", 3),
+			ZScoreThreshold: getFloatEnv("ANOMALY_ZSCORE_THRESHOLD", 3),
+			EWMAAlpha:       getFloatEnv("ANOMALY_EWMA_ALPHA", 0.3),
+		},
+		{
+			Type:      "memory_pressure",
+			Enabled:   getBoolEnv("MEMORY_PRESSURE_ENABLED", true),
+			Threshold: getFloatEnv("MEMORY_PRESSURE_THRESHOLD_BYTES", 1e9),
+		},
+		{
+			Type:      "network_saturation",
+			Enabled:   getBoolEnv("NETWORK_SATURATION_ENABLED", true),
+			Threshold: getFloatEnv("NETWORK_SATURATION_THRESHOLD_BYTES_PER_SEC", 5e7),
+		},
+	}
+}
+
 func validate(cfg *Config) {
 	if cfg.EventSinkURL == "" {
 		log.Fatal("EVENT_SINK_URL is required")
@@ -51,6 +119,18 @@ func validate(cfg *Config) {
 	if cfg.HTTPPort == "" {
 		log.Fatal("HTTP_PORT is required")
 	}
+	if cfg.EventMaxRetries < 0 {
+		log.Fatal("EVENT_MAX_RETRIES must be >= 0")
+	}
+	if cfg.DLQMaxEntries <= 0 {
+		log.Fatal("DLQ_MAX_ENTRIES must be greater than 0")
+	}
+	if cfg.CorrelatorCoalesceWindow <= 0 {
+		log.Fatal("CORRELATOR_COALESCE_WINDOW must be greater than 0")
+	}
+	if cfg.CorrelatorCooldown <= 0 {
+		log.Fatal("CORRELATOR_COOLDOWN must be greater than 0")
+	}
 }
 
 
@@ -62,7 +142,11 @@ func logConfig(cfg *Config) {
 	log.Println("Poll Interval:", cfg.PollInterval)
 	log.Println("Event Sink URL:", cfg.EventSinkURL)
 	log.Println("Event Timeout:", cfg.EventTimeout)
+	log.Println("Event Max Retries:", cfg.EventMaxRetries)
+	log.Println("DLQ Directory:", cfg.DLQDir)
 	log.Println("HTTP Port:", cfg.HTTPPort)
+	log.Println("Detectors configured:", len(cfg.Detectors))
+	log.Println("Correlator store:", cfg.CorrelatorStoreDir)
 }
 
 func getenv(key, defaultValue string) string {
@@ -84,4 +168,40 @@ func getDurationEnv(key string,defaultValue time.Duration) time.Duration {
 		log.Fatalf("Invalid duration value for %s: %s", key, value)
 	}
 	return time.Duration(seconds) * time.Second
+}
+
+func getFloatEnv(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Fatalf("Invalid float value for %s: %s", key, value)
+	}
+	return parsed
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("Invalid int value for %s: %s", key, value)
+	}
+	return parsed
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Fatalf("Invalid bool value for %s: %s", key, value)
+	}
+	return parsed
 }
\ No newline at end of file
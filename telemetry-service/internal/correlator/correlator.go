@@ -0,0 +1,168 @@
+package correlator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/detectors"
+)
+
+// Publisher is the subset of *events.Publisher the correlator needs to
+// emit incident lifecycle CloudEvents.
+type Publisher interface {
+	PublishRaw(ctx context.Context, eventType, subject string, data interface{}) error
+}
+
+// Correlator groups IncidentSignals from the detectors into longer-lived
+// Incidents keyed by (namespace, resource), so repeated firings for the
+// same underlying problem republish as a heartbeat on the open incident
+// instead of a flood of duplicate events.
+type Correlator struct {
+	mu        sync.Mutex
+	store     *Store
+	publisher Publisher
+	incidents map[string]*Incident
+
+	coalesceWindow  time.Duration
+	cooldown        time.Duration
+	onPublishResult func(success bool)
+}
+
+// New builds a Correlator backed by an on-disk incident store, restoring
+// any incidents left open by a previous run. onPublishResult, if non-nil,
+// is called after every incident lifecycle publish attempt so callers
+// (e.g. the scheduler's readiness check) can see whether events are
+// actually reaching the sink rather than just being handed to Ingest.
+func New(cfg *config.Config, publisher Publisher, onPublishResult func(success bool)) (*Correlator, error) {
+	store, err := NewStore(cfg.CorrelatorStoreDir)
+	if err != nil {
+		return nil, err
+	}
+
+	incidents, err := store.LoadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Correlator{
+		store:           store,
+		publisher:       publisher,
+		incidents:       incidents,
+		coalesceWindow:  cfg.CorrelatorCoalesceWindow,
+		cooldown:        cfg.CorrelatorCooldown,
+		onPublishResult: onPublishResult,
+	}
+	log.Printf("Correlator restored %d open incident(s) from disk", len(incidents))
+	return c, nil
+}
+
+// Ingest folds signal into its incident: a fresh (namespace, resource)
+// opens a new incident and publishes incident.opened; a signal for an
+// already-open incident extends LastSeen and appends to RelatedSignals,
+// republishing only as a heartbeat when severity escalates or the
+// coalescing window has elapsed since the last publish.
+func (c *Correlator) Ingest(ctx context.Context, signal *detectors.IncidentSignal) {
+	key := incidentKey(signal.Namespace, signal.Resource)
+
+	c.mu.Lock()
+	incident, exists := c.incidents[key]
+	opened := false
+	heartbeat := false
+	if !exists {
+		incident = &Incident{
+			ID:        key,
+			Namespace: signal.Namespace,
+			Resource:  signal.Resource,
+			Severity:  signal.Severity,
+			FirstSeen: signal.Timestamp,
+		}
+		c.incidents[key] = incident
+		opened = true
+	} else if escalated := maxSeverity(incident.Severity, signal.Severity); escalated != incident.Severity {
+		incident.Severity = escalated
+		heartbeat = true
+	} else if time.Since(incident.LastPublished) >= c.coalesceWindow {
+		heartbeat = true
+	}
+
+	incident.LastSeen = signal.Timestamp
+	incident.Resolved = false
+	incident.RelatedSignals = append(incident.RelatedSignals, *signal)
+	if opened || heartbeat {
+		incident.LastPublished = signal.Timestamp
+	}
+	snapshot := incident.clone()
+	c.mu.Unlock()
+
+	if err := c.store.Save(snapshot); err != nil {
+		log.Printf("Failed to persist incident %s: %v", key, err)
+	}
+
+	switch {
+	case opened:
+		c.publish(ctx, "io.telemetry.incident.opened", snapshot)
+	case heartbeat:
+		c.publish(ctx, "io.telemetry.incident.heartbeat", snapshot)
+	}
+}
+
+// SweepResolved closes any open incident that has gone quiet for the
+// cool-down period, emitting incident.resolved and dropping it from the
+// store. It's meant to be called periodically by the scheduler alongside
+// its collect cycle.
+func (c *Correlator) SweepResolved(ctx context.Context) {
+	c.mu.Lock()
+	var resolved []*Incident
+	for key, incident := range c.incidents {
+		if time.Since(incident.LastSeen) < c.cooldown {
+			continue
+		}
+		incident.Resolved = true
+		resolved = append(resolved, incident.clone())
+		delete(c.incidents, key)
+	}
+	c.mu.Unlock()
+
+	for _, incident := range resolved {
+		if err := c.store.Delete(incident.ID); err != nil {
+			log.Printf("Failed to delete resolved incident %s: %v", incident.ID, err)
+		}
+		c.publish(ctx, "io.telemetry.incident.resolved", incident)
+	}
+}
+
+// Active returns a snapshot of all currently open incidents, for the
+// admin HTTP server's /incidents endpoint.
+func (c *Correlator) Active() []*Incident {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	active := make([]*Incident, 0, len(c.incidents))
+	for _, incident := range c.incidents {
+		active = append(active, incident.clone())
+	}
+	return active
+}
+
+// Close releases the underlying incident store.
+func (c *Correlator) Close() error {
+	return c.store.Close()
+}
+
+func (c *Correlator) publish(ctx context.Context, eventType string, incident *Incident) {
+	subject := incident.Resource
+	if incident.Namespace != "" {
+		subject = fmt.Sprintf("%s/%s", incident.Namespace, incident.Resource)
+	}
+	err := c.publisher.PublishRaw(ctx, eventType, subject, incident)
+	if err != nil {
+		log.Printf("Failed to publish %s for incident %s: %v", eventType, incident.ID, err)
+	}
+	if c.onPublishResult != nil {
+		c.onPublishResult(err == nil)
+	}
+}
@@ -0,0 +1,144 @@
+package correlator
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/detectors"
+)
+
+// fakePublisher records every PublishRaw call instead of sending anywhere.
+type fakePublisher struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (f *fakePublisher) PublishRaw(ctx context.Context, eventType, subject string, data interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, eventType)
+	return nil
+}
+
+func (f *fakePublisher) eventTypes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]string, len(f.calls))
+	copy(out, f.calls)
+	return out
+}
+
+func newTestCorrelator(t *testing.T, coalesceWindow, cooldown time.Duration) (*Correlator, *fakePublisher) {
+	t.Helper()
+	cfg := &config.Config{
+		CorrelatorStoreDir:       filepath.Join(t.TempDir(), "incidents.db"),
+		CorrelatorCoalesceWindow: coalesceWindow,
+		CorrelatorCooldown:       cooldown,
+	}
+	publisher := &fakePublisher{}
+	c, err := New(cfg, publisher, nil)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c, publisher
+}
+
+func signal(severity detectors.Severity) *detectors.IncidentSignal {
+	return &detectors.IncidentSignal{
+		Type:      detectors.SignalCPUSpike,
+		Severity:  severity,
+		Namespace: "default",
+		Resource:  "api-server",
+		Timestamp: time.Now(),
+	}
+}
+
+func TestIngestOpensIncidentOnFirstSignal(t *testing.T) {
+	c, publisher := newTestCorrelator(t, time.Hour, time.Hour)
+
+	c.Ingest(context.Background(), signal(detectors.SeverityMedium))
+
+	if got := publisher.eventTypes(); len(got) != 1 || got[0] != "io.telemetry.incident.opened" {
+		t.Fatalf("publish calls = %v, want [io.telemetry.incident.opened]", got)
+	}
+	active := c.Active()
+	if len(active) != 1 {
+		t.Fatalf("Active() = %d incidents, want 1", len(active))
+	}
+	if len(active[0].RelatedSignals) != 1 {
+		t.Fatalf("RelatedSignals = %d, want 1", len(active[0].RelatedSignals))
+	}
+}
+
+// TestIngestCoalescesWithinWindow asserts that a second same-severity
+// signal for the same incident within the coalescing window is folded in
+// (appended, LastSeen extended) without republishing a duplicate event.
+func TestIngestCoalescesWithinWindow(t *testing.T) {
+	c, publisher := newTestCorrelator(t, time.Hour, time.Hour)
+
+	c.Ingest(context.Background(), signal(detectors.SeverityMedium))
+	c.Ingest(context.Background(), signal(detectors.SeverityMedium))
+
+	if got := publisher.eventTypes(); len(got) != 1 {
+		t.Fatalf("publish calls = %v, want exactly 1 (no duplicate republish)", got)
+	}
+	active := c.Active()
+	if len(active) != 1 || len(active[0].RelatedSignals) != 2 {
+		t.Fatalf("expected 1 incident with 2 related signals, got %+v", active)
+	}
+}
+
+// TestIngestRepublishesOnSeverityEscalation asserts that a subsequent
+// signal with higher severity bumps the incident's severity (max-of) and
+// triggers a heartbeat republish, rather than being silently coalesced.
+func TestIngestRepublishesOnSeverityEscalation(t *testing.T) {
+	c, publisher := newTestCorrelator(t, time.Hour, time.Hour)
+
+	c.Ingest(context.Background(), signal(detectors.SeverityMedium))
+	c.Ingest(context.Background(), signal(detectors.SeverityCritical))
+
+	want := []string{"io.telemetry.incident.opened", "io.telemetry.incident.heartbeat"}
+	if got := publisher.eventTypes(); !equalStrings(got, want) {
+		t.Fatalf("publish calls = %v, want %v", got, want)
+	}
+	active := c.Active()
+	if len(active) != 1 || active[0].Severity != detectors.SeverityCritical {
+		t.Fatalf("expected incident severity to escalate to critical, got %+v", active)
+	}
+}
+
+// TestSweepResolvedClosesQuietIncidents asserts that once an incident has
+// gone quiet for the cool-down period, SweepResolved emits
+// incident.resolved and drops it from the active set.
+func TestSweepResolvedClosesQuietIncidents(t *testing.T) {
+	c, publisher := newTestCorrelator(t, time.Hour, 10*time.Millisecond)
+
+	c.Ingest(context.Background(), signal(detectors.SeverityMedium))
+	time.Sleep(20 * time.Millisecond)
+	c.SweepResolved(context.Background())
+
+	want := []string{"io.telemetry.incident.opened", "io.telemetry.incident.resolved"}
+	if got := publisher.eventTypes(); !equalStrings(got, want) {
+		t.Fatalf("publish calls = %v, want %v", got, want)
+	}
+	if active := c.Active(); len(active) != 0 {
+		t.Fatalf("Active() = %+v, want no open incidents after resolution", active)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package correlator
+
+import (
+	"time"
+
+	"telemetry-service/internal/detectors"
+)
+
+// Incident is a longer-lived grouping of related IncidentSignals for the
+// same (namespace, resource), so repeated detector firings for the same
+// underlying problem surface as one ongoing incident instead of a flood
+// of duplicate events.
+type Incident struct {
+	ID             string                     `json:"id"`
+	Namespace      string                     `json:"namespace,omitempty"`
+	Resource       string                     `json:"resource"`
+	Severity       detectors.Severity         `json:"severity"`
+	FirstSeen      time.Time                  `json:"first_seen"`
+	LastSeen       time.Time                  `json:"last_seen"`
+	LastPublished  time.Time                  `json:"last_published"`
+	RelatedSignals []detectors.IncidentSignal `json:"related_signals"`
+	Resolved       bool                       `json:"resolved"`
+}
+
+// clone returns a deep-enough copy safe to read or publish after the
+// correlator's lock has been released.
+func (i *Incident) clone() *Incident {
+	c := *i
+	c.RelatedSignals = make([]detectors.IncidentSignal, len(i.RelatedSignals))
+	copy(c.RelatedSignals, i.RelatedSignals)
+	return &c
+}
+
+// incidentKey is the grouping key for a signal: one open incident per
+// (namespace, resource) pair.
+func incidentKey(namespace, resource string) string {
+	if namespace == "" {
+		return resource
+	}
+	return namespace + "/" + resource
+}
+
+// maxSeverity returns whichever of a, b ranks higher.
+func maxSeverity(a, b detectors.Severity) detectors.Severity {
+	rank := map[detectors.Severity]int{
+		detectors.SeverityLow:      0,
+		detectors.SeverityMedium:   1,
+		detectors.SeverityHigh:     2,
+		detectors.SeverityCritical: 3,
+	}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
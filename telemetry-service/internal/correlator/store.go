@@ -0,0 +1,78 @@
+package correlator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var incidentsBucket = []byte("incidents")
+
+// Store persists open incidents to an embedded bbolt database, so a
+// service restart doesn't lose track of incidents still in progress.
+type Store struct {
+	db *bolt.DB
+}
+
+func NewStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create incident store directory: %w", err)
+	}
+	db, err := bolt.Open(path, 0o644, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open incident store: %w", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(incidentsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incidents bucket: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Save upserts incident, keyed by its ID.
+func (s *Store) Save(incident *Incident) error {
+	data, err := json.Marshal(incident)
+	if err != nil {
+		return fmt.Errorf("failed to marshal incident: %w", err)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(incidentsBucket).Put([]byte(incident.ID), data)
+	})
+}
+
+// Delete removes a resolved incident from the store.
+func (s *Store) Delete(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(incidentsBucket).Delete([]byte(id))
+	})
+}
+
+// LoadAll returns every persisted incident, keyed by ID, for restoring
+// correlator state on startup.
+func (s *Store) LoadAll() (map[string]*Incident, error) {
+	incidents := make(map[string]*Incident)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(incidentsBucket).ForEach(func(k, v []byte) error {
+			var incident Incident
+			if err := json.Unmarshal(v, &incident); err != nil {
+				return fmt.Errorf("failed to unmarshal incident %q: %w", k, err)
+			}
+			incidents[incident.ID] = &incident
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load incidents: %w", err)
+	}
+	return incidents, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,205 @@
+package detectors
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/metrickey"
+)
+
+func init() {
+	Register("anomaly", func(detCfg config.DetectorConfig) Detector {
+		return NewAnomalyDetector(AnomalyDetectorConfig{
+			MetricType:      detCfg.MetricType,
+			WindowSize:      detCfg.WindowSize,
+			WarmupSamples:   detCfg.WarmupSamples,
+			HysteresisCount: detCfg.HysteresisCount,
+			ZScoreThreshold: detCfg.ZScoreThreshold,
+			EWMAAlpha:       detCfg.EWMAAlpha,
+		})
+	})
+}
+
+// AnomalyDetectorConfig controls the sliding-window anomaly detector.
+// MetricType restricts it to a single metric dimension (e.g. "cpu");
+// leave empty to watch every key AggregateMetrics produces.
+type AnomalyDetectorConfig struct {
+	MetricType      string
+	WindowSize      int
+	WarmupSamples   int
+	HysteresisCount int
+	ZScoreThreshold float64
+	EWMAAlpha       float64
+}
+
+// anomalyState is the rolling state kept for a single metric key across
+// executeCycle invocations.
+type anomalyState struct {
+	window       []float64
+	ewma         float64
+	ewmaVariance float64
+	initialized  bool
+	samples      int
+	consecutive  int
+}
+
+// AnomalyDetector flags metric samples that deviate from their own recent
+// history rather than a fixed threshold. A sample is anomalous when its
+// z-score against the rolling window exceeds ZScoreThreshold, or when its
+// distance from the EWMA exceeds ZScoreThreshold EWMA standard deviations.
+// It warms up for WarmupSamples before firing and requires HysteresisCount
+// consecutive anomalous samples to avoid flapping on single noisy points.
+// Because the scheduler constructs detectors once in New and reuses them
+// for every cycle, the per-key state below simply lives on the struct.
+type AnomalyDetector struct {
+	cfg   AnomalyDetectorConfig
+	mu    sync.Mutex
+	state map[string]*anomalyState
+}
+
+func NewAnomalyDetector(cfg AnomalyDetectorConfig) *AnomalyDetector {
+	if cfg.WindowSize <= 0 {
+		cfg.WindowSize = 20
+	}
+	if cfg.WarmupSamples <= 0 {
+		cfg.WarmupSamples = cfg.WindowSize
+	}
+	if cfg.HysteresisCount <= 0 {
+		cfg.HysteresisCount = 3
+	}
+	if cfg.ZScoreThreshold <= 0 {
+		cfg.ZScoreThreshold = 3
+	}
+	if cfg.EWMAAlpha <= 0 {
+		cfg.EWMAAlpha = 0.3
+	}
+	return &AnomalyDetector{
+		cfg:   cfg,
+		state: make(map[string]*anomalyState),
+	}
+}
+
+func (d *AnomalyDetector) Name() string {
+	return "anomaly_detector"
+}
+
+func (d *AnomalyDetector) Detect(input SignalInput) []*IncidentSignal {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var signals []*IncidentSignal
+	for key, values := range input.Metrics {
+		metricType, _, _ := metrickey.Parse(key)
+		if d.cfg.MetricType != "" && metricType != d.cfg.MetricType {
+			continue
+		}
+		for _, value := range values {
+			if signal, ok := d.observe(key, value); ok {
+				signals = append(signals, signal)
+			}
+		}
+	}
+	return signals
+}
+
+func (d *AnomalyDetector) observe(key string, value float64) (*IncidentSignal, bool) {
+	st, ok := d.state[key]
+	if !ok {
+		st = &anomalyState{}
+		d.state[key] = st
+	}
+
+	st.samples++
+
+	if !st.initialized {
+		st.ewma = value
+		st.initialized = true
+	} else {
+		delta := value - st.ewma
+		st.ewma += d.cfg.EWMAAlpha * delta
+		st.ewmaVariance = (1 - d.cfg.EWMAAlpha) * (st.ewmaVariance + d.cfg.EWMAAlpha*delta*delta)
+	}
+
+	// Score value against the window's prior history before it joins the
+	// window itself, so a genuine spike can't bias its own baseline.
+	mean, stddev := meanStdDev(st.window)
+
+	st.window = append(st.window, value)
+	if len(st.window) > d.cfg.WindowSize {
+		st.window = st.window[len(st.window)-d.cfg.WindowSize:]
+	}
+
+	if st.samples <= d.cfg.WarmupSamples {
+		return nil, false
+	}
+	if stddev == 0 {
+		st.consecutive = 0
+		return nil, false
+	}
+
+	zscore := (value - mean) / stddev
+	ewmaStdDev := math.Sqrt(st.ewmaVariance)
+	anomalous := math.Abs(zscore) >= d.cfg.ZScoreThreshold
+	if ewmaStdDev > 0 {
+		anomalous = anomalous || math.Abs(value-st.ewma) >= d.cfg.ZScoreThreshold*ewmaStdDev
+	}
+
+	if !anomalous {
+		st.consecutive = 0
+		return nil, false
+	}
+	st.consecutive++
+	if st.consecutive < d.cfg.HysteresisCount {
+		return nil, false
+	}
+	st.consecutive = 0
+
+	namespace, resource := parseKey(key)
+	return &IncidentSignal{
+		Type:      SignalAnamoly,
+		Severity:  classifyAnomalySeverity(zscore, d.cfg.ZScoreThreshold),
+		Namespace: namespace,
+		Resource:  resource,
+		Message: fmt.Sprintf(
+			"Anomaly detected on %s: value %.2f is %.2f standard deviations from the rolling mean %.2f",
+			key, value, zscore, mean,
+		),
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"zscore": fmt.Sprintf("%.2f", zscore),
+			"ewma":   fmt.Sprintf("%.2f", st.ewma),
+			"stddev": fmt.Sprintf("%.2f", stddev),
+		},
+	}, true
+}
+
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	mean := average(values)
+	var variance float64
+	for _, v := range values {
+		diff := v - mean
+		variance += diff * diff
+	}
+	variance /= float64(len(values))
+	return mean, math.Sqrt(variance)
+}
+
+func classifyAnomalySeverity(zscore, threshold float64) Severity {
+	abs := math.Abs(zscore)
+	switch {
+	case abs >= threshold*2:
+		return SeverityCritical
+	case abs >= threshold*1.5:
+		return SeverityHigh
+	case abs >= threshold:
+		return SeverityMedium
+	default:
+		return SeverityLow
+	}
+}
@@ -0,0 +1,85 @@
+package detectors
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMeanStdDev(t *testing.T) {
+	mean, stddev := meanStdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	if math.Abs(mean-5) > 1e-9 {
+		t.Fatalf("mean = %v, want 5", mean)
+	}
+	if math.Abs(stddev-2) > 1e-9 {
+		t.Fatalf("stddev = %v, want 2", stddev)
+	}
+}
+
+func TestMeanStdDevEmpty(t *testing.T) {
+	mean, stddev := meanStdDev(nil)
+	if mean != 0 || stddev != 0 {
+		t.Fatalf("meanStdDev(nil) = (%v, %v), want (0, 0)", mean, stddev)
+	}
+}
+
+// TestObserveScoresAgainstPriorWindow guards against a candidate sample
+// contaminating its own baseline. A window of {8, 10, 12, 10} has mean 10,
+// stddev ~1.414; a spike of 100 scored against that prior window has a
+// z-score of ~63.6 (clearly anomalous). If the spike were folded into the
+// window before scoring, the window's own stddev would balloon to ~38.7
+// and the same spike would score a z-score of only ~1.7, below the
+// default threshold of 3 — masking the very anomaly the detector exists
+// to catch.
+func TestObserveScoresAgainstPriorWindow(t *testing.T) {
+	d := NewAnomalyDetector(AnomalyDetectorConfig{
+		WindowSize:      4,
+		WarmupSamples:   4,
+		HysteresisCount: 1,
+		ZScoreThreshold: 3,
+		EWMAAlpha:       0.3,
+	})
+
+	const key = "cpu\x1fdefault\x1fapi-server"
+	for _, v := range []float64{8, 10, 12, 10} {
+		if signal, ok := d.observe(key, v); ok {
+			t.Fatalf("unexpected signal while building the baseline window: %+v", signal)
+		}
+	}
+
+	signal, ok := d.observe(key, 100)
+	if !ok {
+		t.Fatalf("expected the spike to be scored against the prior stable window and flagged")
+	}
+	if got, want := signal.Metadata["stddev"], "1.41"; got[:len(want)] != want {
+		t.Fatalf("stddev = %q, want prefix %q (stddev of the prior window, not the window with the spike folded in)", got, want)
+	}
+}
+
+// TestDetectReportsEveryAnomalousKey guards against Detect stopping at the
+// first anomalous key it visits. Map iteration order is randomized, so a
+// cycle with several anomalous resources (e.g. a cluster-wide spike) must
+// surface all of them, not just whichever key happened to come first.
+func TestDetectReportsEveryAnomalousKey(t *testing.T) {
+	d := NewAnomalyDetector(AnomalyDetectorConfig{
+		WindowSize:      4,
+		WarmupSamples:   4,
+		HysteresisCount: 1,
+		ZScoreThreshold: 3,
+		EWMAAlpha:       0.3,
+	})
+
+	keys := []string{
+		"cpu\x1fdefault\x1fapi-server",
+		"cpu\x1fdefault\x1fworker-1",
+		"cpu\x1fdefault\x1fworker-2",
+	}
+	input := SignalInput{Metrics: make(map[string][]float64)}
+	for _, key := range keys {
+		input.Metrics[key] = []float64{8, 10, 12, 10, 100}
+	}
+
+	signals := d.Detect(input)
+	if len(signals) != len(keys) {
+		t.Fatalf("Detect reported %d signal(s), want %d (one per anomalous key)", len(signals), len(keys))
+	}
+}
@@ -1,10 +1,18 @@
 package detectors
 import (
 	"fmt"
-	"strings"
 	"time"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/metrickey"
 )
 
+func init() {
+	Register("cpu_spike", func(detCfg config.DetectorConfig) Detector {
+		return NewCPUSpikeDetector(detCfg.Threshold)
+	})
+}
+
 type CPUSpikeDetector struct {
 	Threshold float64
 }
@@ -20,15 +28,16 @@ func (d *CPUSpikeDetector) Name() string {
 }
 
 
-func (d *CPUSpikeDetector) Detect(input SignalInput) (*IncidentSignal, bool) {
+func (d *CPUSpikeDetector) Detect(input SignalInput) []*IncidentSignal {
+	var signals []*IncidentSignal
 	for key, values := range input.Metrics {
-		if !strings.HasPrefix(key,"cpu:") {
+		metricType, namespace, resource := metrickey.Parse(key)
+		if metricType != "cpu" {
 			continue
 		}
 		avg := average(values)
 		if avg > d.Threshold {
-			namespace, resource := parseKey(key)
-			signal := &IncidentSignal{
+			signals = append(signals, &IncidentSignal{
 				Type: SignalCPUSpike,
 				Severity: ClassifySeverity(avg, d.Threshold),
 				Namespace: namespace,
@@ -43,11 +52,10 @@ func (d *CPUSpikeDetector) Detect(input SignalInput) (*IncidentSignal, bool) {
 					"average_cpu_millicores": fmt.Sprintf("%.2f", avg),
 					"threshold_millicores": fmt.Sprintf("%.2f", d.Threshold),
 				},
-			}
-			return signal, true
+			})
 		}
 	}
-	return nil, false
+	return signals
 }
 
 
@@ -62,18 +70,11 @@ func average(values []float64) float64 {
 	return sum / float64(len(values))
 }
 
+// parseKey extracts the namespace/resource portion of an aggregated
+// metric key, discarding the metric-type dimension.
 func parseKey(key string) (string, string) {
-	parts := strings.Split(key, ":")
-
-	if len(parts) == 3 {
-		return parts[1], parts[2]
-
-	}
-	if len(parts) == 2 {
-		return "", parts[1]
-	}
-
-	return "", "unknown"
+	_, namespace, resource := metrickey.Parse(key)
+	return namespace, resource
 }
 
 func ClassifySeverity(avg, threshold float64) Severity {
@@ -16,6 +16,8 @@ const (
 	SignalOOM SignalType = "oom"
 	SignalCPUSpike SignalType = "cpu_spike"
 	SignalAnamoly SignalType = "anamoly"
+	SignalMemoryPressure SignalType = "memory_pressure"
+	SignalNetworkSaturation SignalType = "network_saturation"
 )
 
 
@@ -38,5 +40,11 @@ type SignalInput struct {
 
 type Detector interface {
 	Name() string
-	Detect(input SignalInput) (*IncidentSignal, bool)
+	// Detect scores every metric key in input and returns an IncidentSignal
+	// for each anomalous key (AnomalyDetector may return more than one for a
+	// key with several anomalous samples). Detectors keep state per key (e.g. AnomalyDetector's
+	// rolling window), so a cycle with several anomalous resources at once
+	// must surface all of them rather than just the first one map iteration
+	// happens to visit.
+	Detect(input SignalInput) []*IncidentSignal
 }
\ No newline at end of file
@@ -0,0 +1,145 @@
+package detectors
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"telemetry-service/internal/k8s"
+)
+
+// EventDetector reacts to a single k8s.PodEvent rather than a window of
+// aggregated metrics. Event-driven detectors are fed directly by the
+// scheduler's watch ingest path and never see a SignalInput.
+type EventDetector interface {
+	Name() string
+	DetectEvent(evt k8s.PodEvent) (*IncidentSignal, bool)
+}
+
+// dedupeKey identifies a single crash/OOM occurrence so that resyncing
+// the informer cache doesn't republish the same one repeatedly.
+type dedupeKey struct {
+	namespace    string
+	pod          string
+	containerID  string
+	restartCount int32
+}
+
+// dedupeTTL bounds how long a dedupeKey is remembered. Without an
+// eviction horizon, seen would grow without bound across a long-running
+// instance's lifetime of pod restarts; a few coalescing windows is enough
+// to absorb an informer resync without letting the same occurrence
+// republish.
+const dedupeTTL = 30 * time.Minute
+
+// dedupeCache is a TTL-bounded "have I seen this key recently" set,
+// shared by CrashLoopDetector and OOMDetector.
+type dedupeCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[dedupeKey]time.Time
+}
+
+func newDedupeCache(ttl time.Duration) *dedupeCache {
+	return &dedupeCache{ttl: ttl, entries: make(map[dedupeKey]time.Time)}
+}
+
+// seenOrRecord reports whether key was already recorded within ttl,
+// recording it if not. It opportunistically evicts expired entries on
+// every call so the map never grows past roughly one ttl's worth of keys.
+func (c *dedupeCache) seenOrRecord(key dedupeKey) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, seenAt := range c.entries {
+		if now.Sub(seenAt) >= c.ttl {
+			delete(c.entries, k)
+		}
+	}
+
+	if seenAt, ok := c.entries[key]; ok && now.Sub(seenAt) < c.ttl {
+		return true
+	}
+	c.entries[key] = now
+	return false
+}
+
+// CrashLoopDetector flags containers waiting in CrashLoopBackOff,
+// deduplicated by (namespace, pod, containerID, restartCount).
+type CrashLoopDetector struct {
+	seen *dedupeCache
+}
+
+func NewCrashLoopDetector() *CrashLoopDetector {
+	return &CrashLoopDetector{seen: newDedupeCache(dedupeTTL)}
+}
+
+func (d *CrashLoopDetector) Name() string {
+	return "crash_loop_detector"
+}
+
+func (d *CrashLoopDetector) DetectEvent(evt k8s.PodEvent) (*IncidentSignal, bool) {
+	if evt.Reason != "CrashLoopBackOff" {
+		return nil, false
+	}
+	key := dedupeKey{evt.Namespace, evt.Pod, evt.ContainerID, evt.RestartCount}
+	if d.seen.seenOrRecord(key) {
+		return nil, false
+	}
+
+	return &IncidentSignal{
+		Type:      SignalCrashLoop,
+		Severity:  SeverityHigh,
+		Namespace: evt.Namespace,
+		Resource:  evt.Pod,
+		Message: fmt.Sprintf(
+			"Container %s in pod %s is in CrashLoopBackOff (restart count %d)",
+			evt.ContainerName, evt.Pod, evt.RestartCount,
+		),
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"container":     evt.ContainerName,
+			"restart_count": fmt.Sprintf("%d", evt.RestartCount),
+		},
+	}, true
+}
+
+// OOMDetector flags containers killed for exceeding their memory limit,
+// whether observed on the pod's container status (OOMKilled) or via a
+// cluster Event (OOMKilling). Deduplicated the same way as CrashLoopDetector.
+type OOMDetector struct {
+	seen *dedupeCache
+}
+
+func NewOOMDetector() *OOMDetector {
+	return &OOMDetector{seen: newDedupeCache(dedupeTTL)}
+}
+
+func (d *OOMDetector) Name() string {
+	return "oom_detector"
+}
+
+func (d *OOMDetector) DetectEvent(evt k8s.PodEvent) (*IncidentSignal, bool) {
+	if evt.Reason != "OOMKilled" && evt.Reason != "OOMKilling" {
+		return nil, false
+	}
+	key := dedupeKey{evt.Namespace, evt.Pod, evt.ContainerID, evt.RestartCount}
+	if d.seen.seenOrRecord(key) {
+		return nil, false
+	}
+
+	return &IncidentSignal{
+		Type:      SignalOOM,
+		Severity:  SeverityCritical,
+		Namespace: evt.Namespace,
+		Resource:  evt.Pod,
+		Message:   fmt.Sprintf("Container %s in pod %s was OOM killed: %s", evt.ContainerName, evt.Pod, evt.Message),
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"container":     evt.ContainerName,
+			"restart_count": fmt.Sprintf("%d", evt.RestartCount),
+			"reason":        evt.Reason,
+		},
+	}, true
+}
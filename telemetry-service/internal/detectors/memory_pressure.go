@@ -0,0 +1,59 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/metrickey"
+)
+
+func init() {
+	Register("memory_pressure", func(detCfg config.DetectorConfig) Detector {
+		return NewMemoryPressureDetector(detCfg.Threshold)
+	})
+}
+
+// MemoryPressureDetector flags resources whose average memory usage
+// exceeds Threshold bytes.
+type MemoryPressureDetector struct {
+	Threshold float64
+}
+
+func NewMemoryPressureDetector(threshold float64) *MemoryPressureDetector {
+	return &MemoryPressureDetector{Threshold: threshold}
+}
+
+func (d *MemoryPressureDetector) Name() string {
+	return "memory_pressure_detector"
+}
+
+func (d *MemoryPressureDetector) Detect(input SignalInput) []*IncidentSignal {
+	var signals []*IncidentSignal
+	for key, values := range input.Metrics {
+		metricType, namespace, resource := metrickey.Parse(key)
+		if metricType != "memory" {
+			continue
+		}
+		avg := average(values)
+		if avg > d.Threshold {
+			signals = append(signals, &IncidentSignal{
+				Type:      SignalMemoryPressure,
+				Severity:  ClassifySeverity(avg, d.Threshold),
+				Namespace: namespace,
+				Resource:  resource,
+				Message: fmt.Sprintf(
+					"Memory pressure detected: average usage %.2f bytes exceeds threshold %.2f",
+					avg,
+					d.Threshold,
+				),
+				Timestamp: time.Now(),
+				Metadata: map[string]string{
+					"average_memory_bytes": fmt.Sprintf("%.2f", avg),
+					"threshold_bytes":      fmt.Sprintf("%.2f", d.Threshold),
+				},
+			})
+		}
+	}
+	return signals
+}
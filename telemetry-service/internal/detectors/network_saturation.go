@@ -0,0 +1,60 @@
+package detectors
+
+import (
+	"fmt"
+	"time"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/metrickey"
+)
+
+func init() {
+	Register("network_saturation", func(detCfg config.DetectorConfig) Detector {
+		return NewNetworkSaturationDetector(detCfg.Threshold)
+	})
+}
+
+// NetworkSaturationDetector flags resources whose average network
+// throughput (rx+tx bytes/sec, as sampled by NetworkIOCollector) exceeds
+// Threshold.
+type NetworkSaturationDetector struct {
+	Threshold float64
+}
+
+func NewNetworkSaturationDetector(threshold float64) *NetworkSaturationDetector {
+	return &NetworkSaturationDetector{Threshold: threshold}
+}
+
+func (d *NetworkSaturationDetector) Name() string {
+	return "network_saturation_detector"
+}
+
+func (d *NetworkSaturationDetector) Detect(input SignalInput) []*IncidentSignal {
+	var signals []*IncidentSignal
+	for key, values := range input.Metrics {
+		metricType, namespace, resource := metrickey.Parse(key)
+		if metricType != "network_io" {
+			continue
+		}
+		avg := average(values)
+		if avg > d.Threshold {
+			signals = append(signals, &IncidentSignal{
+				Type:      SignalNetworkSaturation,
+				Severity:  ClassifySeverity(avg, d.Threshold),
+				Namespace: namespace,
+				Resource:  resource,
+				Message: fmt.Sprintf(
+					"Network saturation detected: average throughput %.2f bytes/sec exceeds threshold %.2f",
+					avg,
+					d.Threshold,
+				),
+				Timestamp: time.Now(),
+				Metadata: map[string]string{
+					"average_network_bytes_per_sec": fmt.Sprintf("%.2f", avg),
+					"threshold_bytes_per_sec":       fmt.Sprintf("%.2f", d.Threshold),
+				},
+			})
+		}
+	}
+	return signals
+}
@@ -0,0 +1,34 @@
+package detectors
+
+import "telemetry-service/internal/config"
+
+// Factory builds a Detector from a single DetectorConfig entry. Detector
+// implementations register a Factory under their type name from an init()
+// in the file that defines them.
+type Factory func(detCfg config.DetectorConfig) Detector
+
+var registry = map[string]Factory{}
+
+// Register adds a detector factory under the given type name, overwriting
+// any previous registration for that name.
+func Register(name string, factory Factory) {
+	registry[name] = factory
+}
+
+// FromConfig builds the set of detectors described by cfg.Detectors,
+// skipping entries that are disabled or whose type has no registered
+// factory (e.g. a typo in DETECTOR type configuration).
+func FromConfig(cfg *config.Config) []Detector {
+	var built []Detector
+	for _, detCfg := range cfg.Detectors {
+		if !detCfg.Enabled {
+			continue
+		}
+		factory, ok := registry[detCfg.Type]
+		if !ok {
+			continue
+		}
+		built = append(built, factory(detCfg))
+	}
+	return built
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DeadLetterQueue spools CloudEvents that failed to publish after
+// exhausting retries to a bounded on-disk directory, oldest-evicted,
+// so a crash-restart doesn't lose them before the sink recovers.
+type DeadLetterQueue struct {
+	dir        string
+	maxEntries int
+	mu         sync.Mutex
+}
+
+func NewDeadLetterQueue(dir string, maxEntries int) (*DeadLetterQueue, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create DLQ directory: %w", err)
+	}
+	return &DeadLetterQueue{dir: dir, maxEntries: maxEntries}, nil
+}
+
+// Spool writes event to disk, evicting the oldest spooled event first if
+// the queue is already at maxEntries.
+func (q *DeadLetterQueue) Spool(event CloudEvent) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.listEntries()
+	if err == nil && len(entries) >= q.maxEntries {
+		dlqDroppedTotal.Inc()
+		os.Remove(filepath.Join(q.dir, entries[0].Name()))
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spooled event: %w", err)
+	}
+	filename := fmt.Sprintf("%d-%s.json", time.Now().UnixNano(), event.ID)
+	return os.WriteFile(filepath.Join(q.dir, filename), data, 0o644)
+}
+
+// Drain replays spooled events in the order they were written, removing
+// each as it's successfully resent, and stops at the first failure so the
+// remaining backlog is retried on the next pass.
+func (q *DeadLetterQueue) Drain(ctx context.Context, send func(context.Context, CloudEvent) error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.listEntries()
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		path := filepath.Join(q.dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var event CloudEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			os.Remove(path)
+			continue
+		}
+		if err := send(ctx, event); err != nil {
+			return
+		}
+		os.Remove(path)
+	}
+}
+
+// Depth reports how many events are currently spooled.
+func (q *DeadLetterQueue) Depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.listEntries()
+	if err != nil {
+		return 0
+	}
+	return len(entries)
+}
+
+func (q *DeadLetterQueue) listEntries() ([]os.DirEntry, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
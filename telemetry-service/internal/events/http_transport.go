@@ -0,0 +1,55 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"telemetry-service/internal/config"
+)
+
+// HTTPTransport POSTs the CloudEvent to the sink as structured-mode JSON.
+type HTTPTransport struct {
+	client  *http.Client
+	sinkURL string
+}
+
+func NewHTTPTransport(cfg *config.Config) *HTTPTransport {
+	return &HTTPTransport{
+		client:  &http.Client{Timeout: cfg.EventTimeout},
+		sinkURL: cfg.EventSinkURL,
+	}
+}
+
+func (t *HTTPTransport) Send(ctx context.Context, event CloudEvent) error {
+	body, err := marshalCloudEvent(event)
+	if err != nil {
+		return &SendError{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.sinkURL, bytes.NewReader(body))
+	if err != nil {
+		return &SendError{Err: fmt.Errorf("failed to create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return &SendError{Err: fmt.Errorf("failed to publish event: %w", err), Retryable: true}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return &SendError{Err: fmt.Errorf("sink returned status %d", resp.StatusCode), Retryable: true}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &SendError{Err: fmt.Errorf("sink rejected event with status %d", resp.StatusCode)}
+	}
+
+	return nil
+}
+
+func (t *HTTPTransport) Close() error {
+	return nil
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"telemetry-service/internal/config"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaTransport publishes CloudEvents to a Kafka topic, keyed by the
+// event subject so incidents for the same resource land on one partition.
+type KafkaTransport struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaTransport(cfg *config.Config) (*KafkaTransport, error) {
+	parsed, err := url.Parse(cfg.EventSinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka sink URL: %w", err)
+	}
+
+	topic := strings.TrimPrefix(parsed.Path, "/")
+	if topic == "" {
+		topic = "telemetry-incidents"
+	}
+
+	writer := &kafka.Writer{
+		Addr:     kafka.TCP(parsed.Host),
+		Topic:    topic,
+		Balancer: &kafka.Hash{},
+	}
+	return &KafkaTransport{writer: writer}, nil
+}
+
+func (t *KafkaTransport) Send(ctx context.Context, event CloudEvent) error {
+	data, err := marshalCloudEvent(event)
+	if err != nil {
+		return &SendError{Err: err}
+	}
+
+	err = t.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.Subject),
+		Value: data,
+	})
+	if err != nil {
+		return &SendError{Err: fmt.Errorf("failed to publish to Kafka: %w", err), Retryable: true}
+	}
+	return nil
+}
+
+func (t *KafkaTransport) Close() error {
+	return t.writer.Close()
+}
@@ -0,0 +1,42 @@
+package events
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	eventsPublishedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "events_published_total",
+		Help: "Total CloudEvents successfully published to the event sink.",
+	})
+	eventsRetriedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "events_retried_total",
+		Help: "Total publish attempts retried after a transient transport failure.",
+	})
+	dlqDroppedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "events_dlq_dropped_total",
+		Help: "Total spooled events evicted because the dead-letter queue was full.",
+	})
+	eventsDLQTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "events_dlq_total",
+		Help: "Total CloudEvents spooled to the dead-letter queue after exhausting retries.",
+	})
+	eventsDLQDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "events_dlq_depth",
+		Help: "Current number of CloudEvents spooled in the dead-letter queue.",
+	})
+	eventsPublishLatencySeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "events_publish_latency_seconds",
+		Help:    "Time spent in Publisher.Publish or PublishRaw, including retries.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		eventsPublishedTotal,
+		eventsRetriedTotal,
+		dlqDroppedTotal,
+		eventsDLQTotal,
+		eventsDLQDepth,
+		eventsPublishLatencySeconds,
+	)
+}
@@ -1,6 +1,17 @@
 package events
-import "time"
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"telemetry-service/internal/detectors"
+
+	"github.com/google/uuid"
+)
+
+// Event is the service's internal representation of a published incident.
+// It becomes the `data` payload of the CloudEvent wire format.
 type Event struct {
 	ID string `json:"id,omitempty"`
 	Type string `json:"type"`
@@ -11,4 +22,82 @@ type Event struct {
 	Timestamp time.Time `json:"timestamp"`
 	Metadata map[string]string `json:"metadata,omitempty"`
 	Source string `json:"source"`
-}
\ No newline at end of file
+}
+
+// CloudEvent is the CNCF CloudEvents 1.0 structured-mode JSON envelope
+// published to the configured sink, with Event as its data payload.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Type            string          `json:"type"`
+	Source          string          `json:"source"`
+	ID              string          `json:"id"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Subject         string          `json:"subject,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// buildCloudEvent wraps a detected incident signal as a CloudEvent.
+func buildCloudEvent(source string, signal *detectors.IncidentSignal) (CloudEvent, error) {
+	id := uuid.NewString()
+	event := Event{
+		ID:        id,
+		Type:      string(signal.Type),
+		Severity:  string(signal.Severity),
+		Namespace: signal.Namespace,
+		Resource:  signal.Resource,
+		Message:   signal.Message,
+		Timestamp: signal.Timestamp,
+		Metadata:  signal.Metadata,
+		Source:    source,
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return CloudEvent{}, fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	subject := signal.Resource
+	if signal.Namespace != "" {
+		subject = fmt.Sprintf("%s/%s", signal.Namespace, signal.Resource)
+	}
+
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		Type:            fmt.Sprintf("io.telemetry.incident.%s", signal.Type),
+		Source:          source,
+		ID:              id,
+		Time:            signal.Timestamp,
+		DataContentType: "application/json",
+		Subject:         subject,
+		Data:            data,
+	}, nil
+}
+
+// buildRawCloudEvent wraps an arbitrary payload (e.g. a correlator
+// incident) as a CloudEvent under a caller-chosen type, for events that
+// don't originate from a single IncidentSignal.
+func buildRawCloudEvent(source, eventType, subject string, data interface{}) (CloudEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return CloudEvent{}, fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		Type:            eventType,
+		Source:          source,
+		ID:              uuid.NewString(),
+		Time:            time.Now(),
+		DataContentType: "application/json",
+		Subject:         subject,
+		Data:            payload,
+	}, nil
+}
+
+func marshalCloudEvent(event CloudEvent) ([]byte, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cloud event: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"telemetry-service/internal/config"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSTransport publishes CloudEvents as NATS messages on a fixed subject.
+type NATSTransport struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func NewNATSTransport(cfg *config.Config) (*NATSTransport, error) {
+	conn, err := nats.Connect(cfg.EventSinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS sink: %w", err)
+	}
+	return &NATSTransport{conn: conn, subject: "telemetry.incidents"}, nil
+}
+
+func (t *NATSTransport) Send(ctx context.Context, event CloudEvent) error {
+	data, err := marshalCloudEvent(event)
+	if err != nil {
+		return &SendError{Err: err}
+	}
+	if err := t.conn.Publish(t.subject, data); err != nil {
+		return &SendError{Err: fmt.Errorf("failed to publish to NATS: %w", err), Retryable: true}
+	}
+	return nil
+}
+
+func (t *NATSTransport) Close() error {
+	t.conn.Close()
+	return nil
+}
@@ -1,77 +1,151 @@
 package events
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
+	"log"
+	"math/rand"
+	"time"
+
 	"telemetry-service/internal/config"
 	"telemetry-service/internal/detectors"
-
-	"github.com/google/uuid"
 )
 
+// backoffConfig controls the retry schedule Publisher applies to
+// transient (5xx/timeout) transport failures.
+type backoffConfig struct {
+	base       time.Duration
+	max        time.Duration
+	maxRetries int
+}
+
 type Publisher struct {
-	client  *http.Client
-	sinkURL string
-	source  string
+	transport Transport
+	source    string
+	backoff   backoffConfig
+	dlq       *DeadLetterQueue
+
+	drainCancel context.CancelFunc
 }
 
 func NewPublisher(cfg *config.Config) *Publisher {
-	return &Publisher{
-		client: &http.Client{
-			Timeout: cfg.EventTimeout,
+	transport, err := NewTransport(cfg)
+	if err != nil {
+		log.Fatalf("Failed to build event transport: %v", err)
+	}
+	dlq, err := NewDeadLetterQueue(cfg.DLQDir, cfg.DLQMaxEntries)
+	if err != nil {
+		log.Fatalf("Failed to open dead-letter queue: %v", err)
+	}
+
+	p := &Publisher{
+		transport: transport,
+		source:    cfg.ServiceName,
+		backoff: backoffConfig{
+			base:       cfg.EventRetryBackoffBase,
+			max:        cfg.EventRetryBackoffMax,
+			maxRetries: cfg.EventMaxRetries,
 		},
-		sinkURL: cfg.EventSinkURL,
-		source:  cfg.ServiceName,
+		dlq: dlq,
 	}
+
+	drainCtx, cancel := context.WithCancel(context.Background())
+	p.drainCancel = cancel
+	go p.runDLQDrain(drainCtx, cfg.DLQDrainInterval)
+
+	return p
 }
 
 func (p *Publisher) Publish(ctx context.Context, signal *detectors.IncidentSignal) error {
-	event := Event{
-		ID:        uuid.NewString(),
-		Type:      string(signal.Type),
-		Severity:  string(signal.Severity),
-		Namespace: signal.Namespace,
-		Resource:  signal.Resource,
-		Message:   signal.Message,
-		Timestamp: signal.Timestamp,
-		Metadata:  signal.Metadata,
-		Source:    p.source,
-	}
-	body, err := json.Marshal(event)
+	event, err := buildCloudEvent(p.source, signal)
 	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
+		return err
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.sinkURL, bytes.NewBuffer(body))
+	return p.publishEvent(ctx, event)
+}
 
+// PublishRaw publishes an arbitrary payload as a CloudEvent under
+// eventType, for callers (e.g. the correlator) whose events aren't a
+// single IncidentSignal.
+func (p *Publisher) PublishRaw(ctx context.Context, eventType, subject string, data interface{}) error {
+	event, err := buildRawCloudEvent(p.source, eventType, subject, data)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := p.client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to publish event: %w", err)
-	}
-	defer resp.Body.Close()
+	return p.publishEvent(ctx, event)
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
+// publishEvent sends event through the transport with retry, falling
+// back to the dead-letter queue on exhausted retries. Shared by Publish
+// and PublishRaw so both get the same retry/DLQ/metrics behavior.
+func (p *Publisher) publishEvent(ctx context.Context, event CloudEvent) error {
+	start := time.Now()
+	defer func() {
+		eventsPublishLatencySeconds.Observe(time.Since(start).Seconds())
+	}()
 
-	// Read and print the Agent's response
-	var responseMap map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&responseMap); err == nil {
-		fmt.Printf("\n--- Agent Response ---\n")
-		fmt.Printf("Decision: %v\n", responseMap["decision"])
-		fmt.Printf("Message: %v\n", responseMap["message"])
-		fmt.Println("----------------------")
-	} else {
-		// Fallback if not JSON
-		fmt.Println("Agent response received (non-JSON).")
+	if err := p.sendWithRetry(ctx, event); err != nil {
+		eventsDLQTotal.Inc()
+		if spoolErr := p.dlq.Spool(event); spoolErr != nil {
+			return fmt.Errorf("publish failed (%v) and DLQ spool failed: %w", err, spoolErr)
+		}
+		eventsDLQDepth.Set(float64(p.dlq.Depth()))
+		return fmt.Errorf("publish failed after retries, spooled to DLQ: %w", err)
 	}
 
+	eventsPublishedTotal.Inc()
 	return nil
+}
+
+// sendWithRetry sends event, retrying with exponential backoff and jitter
+// on transient (*SendError with Retryable set) failures up to maxRetries.
+func (p *Publisher) sendWithRetry(ctx context.Context, event CloudEvent) error {
+	wait := p.backoff.base
+	for attempt := 0; ; attempt++ {
+		err := p.transport.Send(ctx, event)
+		if err == nil {
+			return nil
+		}
+
+		var sendErr *SendError
+		if !errors.As(err, &sendErr) || !sendErr.Retryable || attempt >= p.backoff.maxRetries {
+			return err
+		}
+		eventsRetriedTotal.Inc()
+
+		jitter := time.Duration(rand.Int63n(int64(wait) + 1))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait + jitter):
+		}
+
+		wait *= 2
+		if wait > p.backoff.max {
+			wait = p.backoff.max
+		}
+	}
+}
+
+// runDLQDrain periodically retries spooled events in case the sink has
+// recovered, stopping as soon as Close cancels ctx.
+func (p *Publisher) runDLQDrain(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.dlq.Drain(ctx, p.transport.Send)
+			eventsDLQDepth.Set(float64(p.dlq.Depth()))
+		}
+	}
+}
 
+// Close stops the background DLQ drain loop and closes the transport.
+func (p *Publisher) Close() error {
+	p.drainCancel()
+	return p.transport.Close()
 }
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"telemetry-service/internal/config"
+)
+
+// Transport delivers a single CloudEvent to the configured sink. A non-nil
+// error from Send should be a *SendError so the Publisher's retry logic
+// can tell a transient failure (5xx, timeout) from a terminal one (4xx).
+type Transport interface {
+	Send(ctx context.Context, event CloudEvent) error
+	Close() error
+}
+
+// SendError wraps a transport failure with whether the Publisher should
+// retry it.
+type SendError struct {
+	Err       error
+	Retryable bool
+}
+
+func (e *SendError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SendError) Unwrap() error {
+	return e.Err
+}
+
+// NewTransport builds the Transport implied by cfg.EventSinkURL's scheme:
+// http(s)://, nats://, or kafka://.
+func NewTransport(cfg *config.Config) (Transport, error) {
+	parsed, err := url.Parse(cfg.EventSinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event sink URL: %w", err)
+	}
+
+	switch parsed.Scheme {
+	case "http", "https":
+		return NewHTTPTransport(cfg), nil
+	case "nats":
+		return NewNATSTransport(cfg)
+	case "kafka":
+		return NewKafkaTransport(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported event sink scheme: %q", parsed.Scheme)
+	}
+}
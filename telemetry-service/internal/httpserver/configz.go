@@ -0,0 +1,54 @@
+package httpserver
+
+import (
+	"net/url"
+
+	"telemetry-service/internal/config"
+)
+
+// redactedConfig is the JSON shape served by /configz: a subset of the
+// loaded Config with secrets stripped from EventSinkURL and durations
+// rendered as human-readable strings instead of nanosecond counts. The
+// retry backoff, DLQ drain interval and correlator settings are not
+// included.
+type redactedConfig struct {
+	ServiceName     string                  `json:"service_name"`
+	Environment     string                  `json:"environment"`
+	ClusterName     string                  `json:"cluster_name"`
+	PollInterval    string                  `json:"poll_interval"`
+	EventSinkURL    string                  `json:"event_sink_url"`
+	EventTimeout    string                  `json:"event_timeout"`
+	EventMaxRetries int                     `json:"event_max_retries"`
+	DLQDir          string                  `json:"dlq_dir"`
+	DLQMaxEntries   int                     `json:"dlq_max_entries"`
+	HTTPPort        string                  `json:"http_port"`
+	Detectors       []config.DetectorConfig `json:"detectors"`
+}
+
+func redact(cfg *config.Config) redactedConfig {
+	return redactedConfig{
+		ServiceName:     cfg.ServiceName,
+		Environment:     cfg.Environment,
+		ClusterName:     cfg.ClusterName,
+		PollInterval:    cfg.PollInterval.String(),
+		EventSinkURL:    redactURL(cfg.EventSinkURL),
+		EventTimeout:    cfg.EventTimeout.String(),
+		EventMaxRetries: cfg.EventMaxRetries,
+		DLQDir:          cfg.DLQDir,
+		DLQMaxEntries:   cfg.DLQMaxEntries,
+		HTTPPort:        cfg.HTTPPort,
+		Detectors:       cfg.Detectors,
+	}
+}
+
+// redactURL replaces the password of any userinfo (user:password@)
+// embedded in a sink URL, e.g. a NATS or Kafka connection string, with
+// REDACTED before it's served over HTTP. The username is kept as is.
+func redactURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.User == nil {
+		return raw
+	}
+	parsed.User = url.UserPassword(parsed.User.Username(), "REDACTED")
+	return parsed.String()
+}
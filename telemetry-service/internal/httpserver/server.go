@@ -0,0 +1,106 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/pprof"
+	"sort"
+
+	"telemetry-service/internal/config"
+	"telemetry-service/internal/correlator"
+	"telemetry-service/internal/scheduler"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// StatsProvider is the subset of *scheduler.Scheduler the admin server
+// needs to answer readiness, stats, and active-incident queries.
+type StatsProvider interface {
+	Stats() scheduler.Stats
+	Ready(ctx context.Context) error
+	Incidents() []*correlator.Incident
+}
+
+// Server exposes liveness/readiness/metrics/config/pprof endpoints
+// alongside the scheduler.
+type Server struct {
+	http  *http.Server
+	cfg   *config.Config
+	stats StatsProvider
+}
+
+func New(cfg *config.Config, stats StatsProvider) *Server {
+	s := &Server{cfg: cfg, stats: stats}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.handleHealthz)
+	mux.HandleFunc("/readyz", s.handleReadyz)
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/configz", s.handleConfigz)
+	mux.HandleFunc("/incidents", s.handleIncidents)
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	s.http = &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.HTTPPort),
+		Handler: mux,
+	}
+	return s
+}
+
+// Start begins serving in the background. It does not block.
+func (s *Server) Start() {
+	go func() {
+		log.Println("Starting admin HTTP server on", s.http.Addr)
+		if err := s.http.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("Admin HTTP server error: %v", err)
+		}
+	}()
+}
+
+// Shutdown gracefully stops the HTTP server, letting in-flight requests
+// finish within ctx's deadline.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.http.Shutdown(ctx)
+}
+
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if err := s.stats.Ready(r.Context()); err != nil {
+		http.Error(w, fmt.Sprintf("not ready: %v", err), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ready"))
+}
+
+func (s *Server) handleConfigz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(redact(s.cfg)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// handleIncidents lists the currently open incidents tracked by the
+// correlator, most recently seen first.
+func (s *Server) handleIncidents(w http.ResponseWriter, r *http.Request) {
+	incidents := s.stats.Incidents()
+	sort.Slice(incidents, func(i, j int) bool {
+		return incidents[i].LastSeen.After(incidents[j].LastSeen)
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(incidents); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -1,15 +1,21 @@
 package k8s
 import (
+	"context"
 	"log"
 	"os"
 	"path/filepath"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	metricsv "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 type Client struct {
 	Clientset *kubernetes.Clientset
+	// MetricsClientset talks to metrics-server (metrics.k8s.io) for
+	// resource usage; collectors needing restart counts or kubelet stats
+	// use Clientset instead.
+	MetricsClientset *metricsv.Clientset
 }
 
 func NewClient() (*Client,error) {
@@ -21,12 +27,23 @@ func NewClient() (*Client,error) {
 	if err != nil {
 		return nil, err
 	}
+	metricsClientset, err := metricsv.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
 	log.Println("Successfully created k8s client")
-	return &Client{Clientset: clientset}, nil
+	return &Client{Clientset: clientset, MetricsClientset: metricsClientset}, nil
 
 
 }
 
+// Ping performs a lightweight call against the API server, for use by
+// readiness checks that need to know whether the cluster is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Clientset.Discovery().RESTClient().Get().AbsPath("/livez").DoRaw(ctx)
+	return err
+}
+
 func buildConfig() (*rest.Config, error) {
 	config,err := rest.InClusterConfig()
 	if err == nil {
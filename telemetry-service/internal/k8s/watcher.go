@@ -0,0 +1,136 @@
+package k8s
+
+import (
+	"context"
+	"log"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PodEvent is a normalized notification about a pod status change or a
+// cluster Event, handed to event-driven detectors outside the regular
+// metric-polling path.
+type PodEvent struct {
+	Namespace     string
+	Pod           string
+	ContainerID   string
+	ContainerName string
+	RestartCount  int32
+	Reason        string // e.g. "CrashLoopBackOff", "OOMKilled", "OOMKilling"
+	Message       string
+	ObservedAt    time.Time
+}
+
+// Watcher streams pod status changes and Events from the cluster via
+// client-go informers, as an alternative ingest path to the poll-based
+// one metrics.Collector implementations use. Callers read PodEvents off
+// Events() and feed them to event-driven detectors.
+type Watcher struct {
+	client *Client
+	resync time.Duration
+	events chan PodEvent
+}
+
+func NewWatcher(client *Client, resync time.Duration) *Watcher {
+	if resync <= 0 {
+		resync = 30 * time.Second
+	}
+	return &Watcher{
+		client: client,
+		resync: resync,
+		events: make(chan PodEvent, 256),
+	}
+}
+
+// Events returns the channel PodEvents are published on. It is closed
+// once ctx passed to Start is cancelled.
+func (w *Watcher) Events() <-chan PodEvent {
+	return w.events
+}
+
+// Start builds a SharedInformerFactory over Pods and Events and begins
+// streaming until ctx is cancelled. It blocks until the informer caches
+// have synced (or ctx is cancelled); streaming then continues in the
+// background.
+func (w *Watcher) Start(ctx context.Context) {
+	factory := informers.NewSharedInformerFactory(w.client.Clientset, w.resync)
+	podInformer := factory.Core().V1().Pods().Informer()
+	eventInformer := factory.Core().V1().Events().Informer()
+
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { w.handlePod(obj) },
+		UpdateFunc: func(_, obj interface{}) { w.handlePod(obj) },
+	})
+	eventInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { w.handleEvent(obj) },
+	})
+
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	go func() {
+		<-ctx.Done()
+		close(w.events)
+	}()
+}
+
+func (w *Watcher) handlePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
+			w.publish(PodEvent{
+				Namespace:     pod.Namespace,
+				Pod:           pod.Name,
+				ContainerID:   cs.ContainerID,
+				ContainerName: cs.Name,
+				RestartCount:  cs.RestartCount,
+				Reason:        "CrashLoopBackOff",
+				Message:       cs.State.Waiting.Message,
+				ObservedAt:    time.Now(),
+			})
+		}
+		if cs.LastTerminationState.Terminated != nil && cs.LastTerminationState.Terminated.Reason == "OOMKilled" {
+			w.publish(PodEvent{
+				Namespace:     pod.Namespace,
+				Pod:           pod.Name,
+				ContainerID:   cs.ContainerID,
+				ContainerName: cs.Name,
+				RestartCount:  cs.RestartCount,
+				Reason:        "OOMKilled",
+				Message:       cs.LastTerminationState.Terminated.Message,
+				ObservedAt:    time.Now(),
+			})
+		}
+	}
+}
+
+func (w *Watcher) handleEvent(obj interface{}) {
+	event, ok := obj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	if event.Reason != "OOMKilling" {
+		return
+	}
+	w.publish(PodEvent{
+		Namespace:  event.InvolvedObject.Namespace,
+		Pod:        event.InvolvedObject.Name,
+		Reason:     "OOMKilling",
+		Message:    event.Message,
+		ObservedAt: time.Now(),
+	})
+}
+
+func (w *Watcher) publish(evt PodEvent) {
+	select {
+	case w.events <- evt:
+	default:
+		log.Printf("watcher: dropping event for %s/%s, channel full", evt.Namespace, evt.Pod)
+	}
+}
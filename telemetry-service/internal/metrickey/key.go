@@ -0,0 +1,33 @@
+// Package metrickey builds and parses the map keys metrics.AggregateMetrics
+// produces, shared by the metrics and detectors packages so they can't
+// drift out of sync.
+package metrickey
+
+import "strings"
+
+// delimiter separates the type, namespace, and resource components of a
+// key. Unlike ':', it's a control character that can't appear in k8s
+// resource or container names, so Parse round-trips cleanly.
+const delimiter = "\x1f"
+
+// Build joins metricType, namespace, and resource into a single map key.
+// namespace may be empty for cluster-scoped resources.
+func Build(metricType, namespace, resource string) string {
+	if namespace == "" {
+		return strings.Join([]string{metricType, resource}, delimiter)
+	}
+	return strings.Join([]string{metricType, namespace, resource}, delimiter)
+}
+
+// Parse splits a key built by Build back into its components.
+func Parse(key string) (metricType, namespace, resource string) {
+	parts := strings.Split(key, delimiter)
+	switch len(parts) {
+	case 3:
+		return parts[0], parts[1], parts[2]
+	case 2:
+		return parts[0], "", parts[1]
+	default:
+		return "", "", key
+	}
+}
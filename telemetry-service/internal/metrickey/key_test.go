@@ -0,0 +1,36 @@
+package metrickey
+
+import "testing"
+
+func TestBuildParseRoundTrip(t *testing.T) {
+	cases := []struct {
+		name      string
+		metricType string
+		namespace string
+		resource  string
+	}{
+		{"namespaced", "cpu", "default", "api-server"},
+		{"cluster-scoped", "disk_io", "", "node-1"},
+		{"resource contains colon", "memory", "kube-system", "coredns:9153"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := Build(tc.metricType, tc.namespace, tc.resource)
+			gotType, gotNamespace, gotResource := Parse(key)
+			if gotType != tc.metricType || gotNamespace != tc.namespace || gotResource != tc.resource {
+				t.Fatalf("Parse(Build(%q, %q, %q)) = (%q, %q, %q), want (%q, %q, %q)",
+					tc.metricType, tc.namespace, tc.resource,
+					gotType, gotNamespace, gotResource,
+					tc.metricType, tc.namespace, tc.resource)
+			}
+		})
+	}
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	metricType, namespace, resource := Parse("not-a-built-key")
+	if metricType != "" || namespace != "" || resource != "not-a-built-key" {
+		t.Fatalf("Parse(%q) = (%q, %q, %q), want (\"\", \"\", %q)", "not-a-built-key", metricType, namespace, resource, "not-a-built-key")
+	}
+}
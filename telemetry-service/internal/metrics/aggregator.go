@@ -1,7 +1,7 @@
 package metrics
 import (
-	"fmt"
 	"telemetry-service/internal/detectors"
+	"telemetry-service/internal/metrickey"
 )
 
 func AggregateMetrics(metrics []Metric) detectors.SignalInput {
@@ -20,12 +20,9 @@ func AggregateMetrics(metrics []Metric) detectors.SignalInput {
 		Labels: labels,
 	}
 
-	
+
 }
 
 func buildMetricKey(m Metric) string {
-	if m.Namespace !="" {
-		return fmt.Sprintf("%s:%s:%s", m.Type, m.Namespace, m.Resource)
-	}
-	return fmt.Sprintf("%s:%s", m.Type, m.Resource)
-}
\ No newline at end of file
+	return metrickey.Build(string(m.Type), m.Namespace, m.Resource)
+}
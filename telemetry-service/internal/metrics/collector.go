@@ -0,0 +1,33 @@
+package metrics
+
+import "context"
+
+// MetricType identifies which resource dimension a Metric measures.
+type MetricType string
+
+const (
+	MetricTypeCPU         MetricType = "cpu"
+	MetricTypeMemory      MetricType = "memory"
+	MetricTypeNetworkIO   MetricType = "network_io"
+	MetricTypeDiskIO      MetricType = "disk_io"
+	MetricTypePodRestarts MetricType = "pod_restarts"
+)
+
+// Metric is a single sample collected for one resource.
+type Metric struct {
+	Type      MetricType
+	Namespace string
+	Resource  string
+	Value     float64
+	Labels    map[string]string
+}
+
+// Collector gathers Metrics for one resource dimension (CPU, memory,
+// network, ...). HealthCheck lets the scheduler detect a collector whose
+// backend is currently unreachable (e.g. metrics-server not installed)
+// and skip it for a cycle instead of silently dropping its data.
+type Collector interface {
+	Name() string
+	Collect(ctx context.Context) ([]Metric, error)
+	HealthCheck(ctx context.Context) error
+}
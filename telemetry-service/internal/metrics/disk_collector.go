@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"telemetry-service/internal/k8s"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DiskIOCollector reads per-pod ephemeral storage usage from each node's
+// kubelet summary API, the same source NetworkIOCollector uses.
+type DiskIOCollector struct {
+	client *k8s.Client
+}
+
+func NewDiskIOCollector(client *k8s.Client) *DiskIOCollector {
+	return &DiskIOCollector{client: client}
+}
+
+func (c *DiskIOCollector) Name() string {
+	return "disk_io_collector"
+}
+
+func (c *DiskIOCollector) Collect(ctx context.Context) ([]Metric, error) {
+	nodes, err := c.client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var metrics []Metric
+	for _, node := range nodes.Items {
+		summary, err := fetchNodeSummary(ctx, c.client, node.Name)
+		if err != nil {
+			continue
+		}
+		for _, pod := range summary.Pods {
+			if pod.EphemeralStorage == nil || pod.EphemeralStorage.UsedBytes == nil {
+				continue
+			}
+			metrics = append(metrics, Metric{
+				Type:      MetricTypeDiskIO,
+				Namespace: pod.PodRef.Namespace,
+				Resource:  pod.PodRef.Name,
+				Value:     float64(*pod.EphemeralStorage.UsedBytes),
+			})
+		}
+	}
+	return metrics, nil
+}
+
+func (c *DiskIOCollector) HealthCheck(ctx context.Context) error {
+	nodes, err := c.client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return fmt.Errorf("k8s API unavailable: %w", err)
+	}
+	if len(nodes.Items) == 0 {
+		return fmt.Errorf("no nodes available to sample kubelet stats from")
+	}
+	_, err = fetchNodeSummary(ctx, c.client, nodes.Items[0].Name)
+	if err != nil {
+		return fmt.Errorf("kubelet summary API unavailable: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"telemetry-service/internal/k8s"
+
+	statsv1alpha1 "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+// fetchNodeSummary fetches and decodes one node's kubelet summary API
+// (/stats/summary). NetworkIOCollector and DiskIOCollector both read
+// pod-level stats from it, since metrics.k8s.io only exposes CPU/memory.
+func fetchNodeSummary(ctx context.Context, client *k8s.Client, nodeName string) (*statsv1alpha1.Summary, error) {
+	data, err := client.Clientset.CoreV1().RESTClient().
+		Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix("stats/summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var summary statsv1alpha1.Summary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, fmt.Errorf("failed to decode stats summary: %w", err)
+	}
+	return &summary, nil
+}
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"telemetry-service/internal/k8s"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemoryCollector reads per-container memory usage from metrics-server.
+type MemoryCollector struct {
+	client *k8s.Client
+}
+
+func NewMemoryCollector(client *k8s.Client) *MemoryCollector {
+	return &MemoryCollector{client: client}
+}
+
+func (c *MemoryCollector) Name() string {
+	return "memory_collector"
+}
+
+func (c *MemoryCollector) Collect(ctx context.Context) ([]Metric, error) {
+	podMetricsList, err := c.client.MetricsClientset.MetricsV1beta1().PodMetricses("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod metrics: %w", err)
+	}
+
+	var metrics []Metric
+	for _, podMetrics := range podMetricsList.Items {
+		for _, container := range podMetrics.Containers {
+			metrics = append(metrics, Metric{
+				Type:      MetricTypeMemory,
+				Namespace: podMetrics.Namespace,
+				Resource:  podMetrics.Name,
+				Value:     float64(container.Usage.Memory().Value()),
+				Labels: map[string]string{
+					"container": container.Name,
+				},
+			})
+		}
+	}
+	return metrics, nil
+}
+
+func (c *MemoryCollector) HealthCheck(ctx context.Context) error {
+	_, err := c.client.MetricsClientset.MetricsV1beta1().PodMetricses("").List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return fmt.Errorf("metrics-server unavailable: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"telemetry-service/internal/k8s"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkIOCollector reads per-pod network throughput from each node's
+// kubelet summary API, since metrics-server doesn't expose it. The
+// kubelet only reports cumulative lifetime rx/tx byte counters, so the
+// collector keeps the previous sample per pod and diffs against it to
+// produce a bytes/sec rate instead of an ever-growing total.
+type NetworkIOCollector struct {
+	client *k8s.Client
+
+	mu      sync.Mutex
+	samples map[string]networkSample
+}
+
+// networkSample is the last cumulative rx+tx total observed for a pod,
+// and when it was observed.
+type networkSample struct {
+	total uint64
+	at    time.Time
+}
+
+func NewNetworkIOCollector(client *k8s.Client) *NetworkIOCollector {
+	return &NetworkIOCollector{client: client, samples: make(map[string]networkSample)}
+}
+
+func (c *NetworkIOCollector) Name() string {
+	return "network_io_collector"
+}
+
+func (c *NetworkIOCollector) Collect(ctx context.Context) ([]Metric, error) {
+	nodes, err := c.client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var metrics []Metric
+	for _, node := range nodes.Items {
+		summary, err := fetchNodeSummary(ctx, c.client, node.Name)
+		if err != nil {
+			continue
+		}
+		for _, pod := range summary.Pods {
+			if pod.Network == nil {
+				continue
+			}
+			var total uint64
+			if pod.Network.RxBytes != nil {
+				total += *pod.Network.RxBytes
+			}
+			if pod.Network.TxBytes != nil {
+				total += *pod.Network.TxBytes
+			}
+
+			key := pod.PodRef.Namespace + "/" + pod.PodRef.Name
+			now := time.Now()
+			prev, seen := c.samples[key]
+			c.samples[key] = networkSample{total: total, at: now}
+
+			if !seen {
+				// No prior sample to diff against yet; wait for the next cycle.
+				continue
+			}
+			elapsed := now.Sub(prev.at).Seconds()
+			if elapsed <= 0 || total < prev.total {
+				// Clock anomaly or a counter reset from a container
+				// restart; the new total above is now the fresh baseline.
+				continue
+			}
+			metrics = append(metrics, Metric{
+				Type:      MetricTypeNetworkIO,
+				Namespace: pod.PodRef.Namespace,
+				Resource:  pod.PodRef.Name,
+				Value:     float64(total-prev.total) / elapsed,
+			})
+		}
+	}
+	return metrics, nil
+}
+
+func (c *NetworkIOCollector) HealthCheck(ctx context.Context) error {
+	nodes, err := c.client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return fmt.Errorf("k8s API unavailable: %w", err)
+	}
+	if len(nodes.Items) == 0 {
+		return fmt.Errorf("no nodes available to sample kubelet stats from")
+	}
+	_, err = fetchNodeSummary(ctx, c.client, nodes.Items[0].Name)
+	if err != nil {
+		return fmt.Errorf("kubelet summary API unavailable: %w", err)
+	}
+	return nil
+}
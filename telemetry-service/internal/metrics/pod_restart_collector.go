@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"telemetry-service/internal/k8s"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodRestartCollector sums container restart counts from the core API;
+// metrics-server doesn't expose these, only CPU/memory resource usage.
+type PodRestartCollector struct {
+	client *k8s.Client
+}
+
+func NewPodRestartCollector(client *k8s.Client) *PodRestartCollector {
+	return &PodRestartCollector{client: client}
+}
+
+func (c *PodRestartCollector) Name() string {
+	return "pod_restart_collector"
+}
+
+func (c *PodRestartCollector) Collect(ctx context.Context) ([]Metric, error) {
+	pods, err := c.client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var metrics []Metric
+	for _, pod := range pods.Items {
+		var restarts int32
+		for _, cs := range pod.Status.ContainerStatuses {
+			restarts += cs.RestartCount
+		}
+		metrics = append(metrics, Metric{
+			Type:      MetricTypePodRestarts,
+			Namespace: pod.Namespace,
+			Resource:  pod.Name,
+			Value:     float64(restarts),
+		})
+	}
+	return metrics, nil
+}
+
+func (c *PodRestartCollector) HealthCheck(ctx context.Context) error {
+	_, err := c.client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return fmt.Errorf("k8s API unavailable: %w", err)
+	}
+	return nil
+}
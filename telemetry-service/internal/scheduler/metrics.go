@@ -0,0 +1,22 @@
+package scheduler
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	cyclesExecutedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "scheduler_cycles_executed_total",
+		Help: "Total executeCycle runs completed.",
+	})
+	metricsCollectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_metrics_collected_total",
+		Help: "Total metric samples collected, labeled by collector.",
+	}, []string{"collector"})
+	signalsEmittedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_signals_emitted_total",
+		Help: "Total incident signals emitted, labeled by type and severity.",
+	}, []string{"type", "severity"})
+)
+
+func init() {
+	prometheus.MustRegister(cyclesExecutedTotal, metricsCollectedTotal, signalsEmittedTotal)
+}
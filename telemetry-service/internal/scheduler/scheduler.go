@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
 	"time"
 	"log"
 	"sync"
@@ -10,6 +11,7 @@ import (
 	"telemetry-service/internal/detectors"
 	"telemetry-service/internal/metrics"
 	"telemetry-service/internal/events"
+	"telemetry-service/internal/correlator"
 )
 
 type Scheduler struct {
@@ -17,11 +19,36 @@ type Scheduler struct {
 	client *k8s.Client
 	collectors []metrics.Collector
 	detectors []detectors.Detector
+	eventDetectors []detectors.EventDetector
+	watcher *k8s.Watcher
 	ctx context.Context
 	cancel context.CancelFunc
 	wg sync.WaitGroup
 	publisher *events.Publisher
+	correlator *correlator.Correlator
+	publishTimeout time.Duration
 
+	statsMu sync.RWMutex
+	stats Stats
+
+}
+
+// Stats is a point-in-time snapshot of scheduler activity, read by Ready
+// for the admin HTTP server's /readyz handler.
+type Stats struct {
+	CyclesExecuted   int64
+	LastCollectTime  time.Time
+	// LastPublishTime is the last time an incident lifecycle event was
+	// actually confirmed delivered to the sink (not merely handed to the
+	// correlator); zero if no publish has succeeded yet.
+	LastPublishTime time.Time
+	// LastPublishAttempt/LastPublishFailed track the most recent publish
+	// attempt regardless of outcome, so Ready can detect a sink that's
+	// silently failing every publish into the DLQ.
+	LastPublishAttempt time.Time
+	LastPublishFailed  bool
+	MetricsCollected   map[string]int64
+	SignalsEmitted     map[string]int64
 }
 
 
@@ -36,17 +63,36 @@ func New(cfg *config.Config, client *k8s.Client) *Scheduler {
 
 	s.collectors = []metrics.Collector{
 		metrics.NewCPUCollector(client),
+		metrics.NewMemoryCollector(client),
+		metrics.NewNetworkIOCollector(client),
+		metrics.NewDiskIOCollector(client),
+		metrics.NewPodRestartCollector(client),
 	}
-	s.detectors = []detectors.Detector{
-		detectors.NewCPUSpikeDetector(600),
+	s.detectors = detectors.FromConfig(cfg)
+	s.eventDetectors = []detectors.EventDetector{
+		detectors.NewCrashLoopDetector(),
+		detectors.NewOOMDetector(),
 	}
+	s.watcher = k8s.NewWatcher(client, cfg.PollInterval)
 	s.publisher = events.NewPublisher(cfg)
+	corr, err := correlator.New(cfg, s.publisher, s.recordPublishResult)
+	if err != nil {
+		log.Fatalf("Failed to create correlator: %v", err)
+	}
+	s.correlator = corr
+	// publishTimeout bounds a single publish's internal retry/backoff so
+	// Stop()'s wg.Wait() can't block forever draining a dead sink, while
+	// still giving it room for the full retry schedule sendWithRetry can run.
+	s.publishTimeout = cfg.EventRetryBackoffMax * time.Duration(cfg.EventMaxRetries+1)
 	return s
 
 }
 
 func (s *Scheduler) Start() {
 	log.Println("Starting Scheduler....!")
+	s.watcher.Start(s.ctx)
+	s.wg.Add(1)
+	go s.watchEvents()
 	s.wg.Add(1)
 	go s.run()
 }
@@ -55,9 +101,91 @@ func (s *Scheduler) Stop() {
 	log.Println("Stopping Scheduler....!")
 	s.cancel()
 	s.wg.Wait()
+	if err := s.publisher.Close(); err != nil {
+		log.Printf("Failed to close publisher: %v", err)
+	}
+	if err := s.correlator.Close(); err != nil {
+		log.Printf("Failed to close correlator: %v", err)
+	}
 	log.Println("Scheduler stopped.")
 }
 
+// Incidents returns the currently open incidents, for the admin HTTP
+// server's /incidents endpoint.
+func (s *Scheduler) Incidents() []*correlator.Incident {
+	return s.correlator.Active()
+}
+
+// Stats returns a snapshot of the scheduler's counters, safe to read
+// while executeCycle/watchEvents continue to run concurrently.
+func (s *Scheduler) Stats() Stats {
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+
+	metricsCollected := make(map[string]int64, len(s.stats.MetricsCollected))
+	for k, v := range s.stats.MetricsCollected {
+		metricsCollected[k] = v
+	}
+	signalsEmitted := make(map[string]int64, len(s.stats.SignalsEmitted))
+	for k, v := range s.stats.SignalsEmitted {
+		signalsEmitted[k] = v
+	}
+
+	return Stats{
+		CyclesExecuted:     s.stats.CyclesExecuted,
+		LastCollectTime:    s.stats.LastCollectTime,
+		LastPublishTime:    s.stats.LastPublishTime,
+		LastPublishAttempt: s.stats.LastPublishAttempt,
+		LastPublishFailed:  s.stats.LastPublishFailed,
+		MetricsCollected:   metricsCollected,
+		SignalsEmitted:     signalsEmitted,
+	}
+}
+
+// Ready reports whether the scheduler is fit to serve traffic: the k8s
+// API must be reachable, once the first cycle has run the last successful
+// collect must be within 2x the poll interval, and if a publish has been
+// attempted recently it must not have failed (a sink that's silently
+// failing every publish into the DLQ should flip this unready).
+func (s *Scheduler) Ready(ctx context.Context) error {
+	if err := s.client.Ping(ctx); err != nil {
+		return fmt.Errorf("k8s API unreachable: %w", err)
+	}
+
+	stats := s.Stats()
+	maxAge := 2 * s.cfg.PollInterval
+
+	if stats.CyclesExecuted > 0 {
+		if age := time.Since(stats.LastCollectTime); age > maxAge {
+			return fmt.Errorf("last collect was %s ago, exceeds %s", age, maxAge)
+		}
+	}
+
+	if !stats.LastPublishAttempt.IsZero() && stats.LastPublishFailed {
+		if age := time.Since(stats.LastPublishAttempt); age <= maxAge {
+			return fmt.Errorf("last publish attempt %s ago failed", age)
+		}
+	}
+
+	return nil
+}
+
+// watchEvents is the scheduler's second ingest path: it never goes through
+// AggregateMetrics, instead feeding PodEvents from the k8s.Watcher straight
+// to the event-driven detectors as they arrive.
+func (s *Scheduler) watchEvents() {
+	defer s.wg.Done()
+	for evt := range s.watcher.Events() {
+		for _, detector := range s.eventDetectors {
+			signal, ok := detector.DetectEvent(evt)
+			if !ok {
+				continue
+			}
+			s.publish(signal)
+		}
+	}
+}
+
 func (s *Scheduler) run() {
 	defer s.wg.Done()
 	ticker := time.NewTicker(s.cfg.PollInterval)
@@ -68,6 +196,9 @@ func (s *Scheduler) run() {
 			return
 		case <-ticker.C:
 			s.executeCycle()
+			sweepCtx, cancel := context.WithTimeout(context.Background(), s.publishTimeout)
+			s.correlator.SweepResolved(sweepCtx)
+			cancel()
 		}
 	}
 }
@@ -78,40 +209,103 @@ func (s *Scheduler) executeCycle() {
 	// Collect Metrics
 
 	var allMetrics []metrics.Metric
+	collectedAny := false
 	for _, collector := range s.collectors {
+		if err := collector.HealthCheck(s.ctx); err != nil {
+			log.Printf("Skipping collector %s, health check failed: %v", collector.Name(), err)
+			continue
+		}
 		collected, err := collector.Collect(s.ctx)
 		if err != nil {
 			log.Printf("Failed to collect metrics from %s: %v", collector.Name(), err)
 			continue
 		}
+		collectedAny = true
 		log.Printf("Collected %d metrics from %s", len(collected), collector.Name())
+		s.recordMetricsCollected(collector.Name(), len(collected))
 		allMetrics = append(allMetrics, collected...)
 	}
+	s.recordCycle(collectedAny)
 	if len(allMetrics) == 0 {
 		log.Println("No metrics collected in this cycle")
 		return
 	}
 	AggregatedMetrics := metrics.AggregateMetrics(allMetrics)
-	for _, detectors := range s.detectors {
-		signal,ok := detectors.Detect(AggregatedMetrics)
-		if !ok {
-			continue
-		}
-		err := s.publisher.Publish(s.ctx, signal)
-		if err != nil {
-			log.Printf("❌ Failed to publish event: %v", err)
-			continue
+	for _, detector := range s.detectors {
+		for _, signal := range detector.Detect(AggregatedMetrics) {
+			s.publish(signal)
 		}
+	}
+	elapsed := time.Since(start)
+	log.Println("Cycle completed in", elapsed)
 
-		log.Printf(
-		"📤 Event published [%s] severity=%s resource=%s",
+}
+
+// publish hands a signal to the correlator, which folds it into its
+// (namespace, resource) incident and publishes incident.opened/heartbeat
+// as warranted instead of republishing every single signal. Shared by
+// the metric-polling cycle and the watch-based event ingest path.
+//
+// The correlator is given a context independent of s.ctx, bounded only by
+// publishTimeout, so a Stop() mid-publish lets the in-flight publish (and
+// its retry/backoff) finish or time out on its own terms instead of being
+// hard-aborted by Stop's cancel().
+func (s *Scheduler) publish(signal *detectors.IncidentSignal) {
+	publishCtx, cancel := context.WithTimeout(context.Background(), s.publishTimeout)
+	defer cancel()
+
+	s.correlator.Ingest(publishCtx, signal)
+	s.recordSignalEmitted(signal)
+	log.Printf(
+		"Signal ingested [%s] severity=%s resource=%s",
 		signal.Type,
 		signal.Severity,
 		signal.Resource,
 	)
+}
 
+func (s *Scheduler) recordCycle(collected bool) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	s.stats.CyclesExecuted++
+	if collected {
+		s.stats.LastCollectTime = time.Now()
 	}
-	elapsed := time.Since(start)
-	log.Println("Cycle completed in", elapsed)
+	cyclesExecutedTotal.Inc()
+}
 
+func (s *Scheduler) recordMetricsCollected(collector string, count int) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	if s.stats.MetricsCollected == nil {
+		s.stats.MetricsCollected = make(map[string]int64)
+	}
+	s.stats.MetricsCollected[collector] += int64(count)
+	metricsCollectedTotal.WithLabelValues(collector).Add(float64(count))
+}
+
+func (s *Scheduler) recordSignalEmitted(signal *detectors.IncidentSignal) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	if s.stats.SignalsEmitted == nil {
+		s.stats.SignalsEmitted = make(map[string]int64)
+	}
+	key := fmt.Sprintf("%s:%s", signal.Type, signal.Severity)
+	s.stats.SignalsEmitted[key]++
+	signalsEmittedTotal.WithLabelValues(string(signal.Type), string(signal.Severity)).Inc()
+}
+
+// recordPublishResult is the correlator's onPublishResult callback: it
+// records whether the most recent incident lifecycle publish actually
+// reached the sink, so Ready can detect a sink that's silently failing
+// every publish into the DLQ.
+func (s *Scheduler) recordPublishResult(success bool) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	now := time.Now()
+	s.stats.LastPublishAttempt = now
+	s.stats.LastPublishFailed = !success
+	if success {
+		s.stats.LastPublishTime = now
+	}
 }
\ No newline at end of file